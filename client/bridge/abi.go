@@ -0,0 +1,253 @@
+package bridge
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/binance-chain/go-sdk/common/types"
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// word is a single 32-byte ABI-encoded slot.
+const word = 32
+
+// nativeBNBDenom is the relay/bind fee denom charged on the BC side.
+const nativeBNBDenom = "BNB"
+
+func newBNBCoin(amount int64) sdk.Coin {
+	return sdk.Coin{Denom: nativeBNBDenom, Amount: amount}
+}
+
+// decodeWords splits ABI-encoded log data, head and tail alike, into its
+// 32-byte words; callers read the leading head words. Dynamic fields (string, T[]) occupy a single head
+// word holding a byte offset into data where their actual contents live in
+// the tail, per the Solidity ABI spec.
+func decodeWords(data []byte) ([][]byte, error) {
+	if len(data)%word != 0 {
+		return nil, fmt.Errorf("log data length %d is not a multiple of %d", len(data), word)
+	}
+	words := make([][]byte, len(data)/word)
+	for i := range words {
+		words[i] = data[i*word : (i+1)*word]
+	}
+	return words, nil
+}
+
+func wordToInt64(w []byte) int64 {
+	return new(big.Int).SetBytes(w).Int64()
+}
+
+func wordToSmartChainAddress(w []byte) msg.SmartChainAddress {
+	var addr msg.SmartChainAddress
+	addr.SetBytes(w)
+	return addr
+}
+
+// dynamicOffset reads a head word as the byte offset of a dynamic field's
+// tail data, relative to the start of data.
+func dynamicOffset(head []byte) (int, error) {
+	offset := new(big.Int).SetBytes(head)
+	if !offset.IsInt64() || offset.Int64() < 0 {
+		return 0, fmt.Errorf("dynamic offset out of range")
+	}
+	return int(offset.Int64()), nil
+}
+
+// decodeDynamicLength reads the 32-byte length word at the start of a
+// dynamic field's tail data.
+func decodeDynamicLength(data []byte, offset int) (int, error) {
+	if offset < 0 || offset+word > len(data) {
+		return 0, fmt.Errorf("length word at offset %d out of range", offset)
+	}
+	length := new(big.Int).SetBytes(data[offset : offset+word])
+	if !length.IsInt64() || length.Sign() < 0 {
+		return 0, fmt.Errorf("length at offset %d out of range", offset)
+	}
+	return int(length.Int64()), nil
+}
+
+// decodeAddressArray decodes a dynamic `address[]` value whose tail begins
+// at offset within data, returning each element's raw 20 address bytes.
+func decodeAddressArray(data []byte, offset int) ([][]byte, error) {
+	length, err := decodeDynamicLength(data, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	start := offset + word
+	end := start + length*word
+	if end > len(data) {
+		return nil, fmt.Errorf("address[] of length %d at offset %d out of range", length, offset)
+	}
+
+	addrs := make([][]byte, length)
+	for i := 0; i < length; i++ {
+		elem := data[start+i*word : start+(i+1)*word]
+		addrs[i] = elem[word-20:]
+	}
+	return addrs, nil
+}
+
+// decodeUint256Array decodes a dynamic `uint256[]` value whose tail begins
+// at offset within data.
+func decodeUint256Array(data []byte, offset int) ([]int64, error) {
+	length, err := decodeDynamicLength(data, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	start := offset + word
+	end := start + length*word
+	if end > len(data) {
+		return nil, fmt.Errorf("uint256[] of length %d at offset %d out of range", length, offset)
+	}
+
+	amounts := make([]int64, length)
+	for i := 0; i < length; i++ {
+		amounts[i] = wordToInt64(data[start+i*word : start+(i+1)*word])
+	}
+	return amounts, nil
+}
+
+// decodeString decodes a dynamic `string` value whose tail begins at offset
+// within data.
+func decodeString(data []byte, offset int) (string, error) {
+	length, err := decodeDynamicLength(data, offset)
+	if err != nil {
+		return "", err
+	}
+
+	start := offset + word
+	end := start + length
+	if end > len(data) {
+		return "", fmt.Errorf("string of length %d at offset %d out of range", length, offset)
+	}
+	return string(data[start:end]), nil
+}
+
+// decodeLockEvent decodes the bridge contract's LockEvent log, emitted when
+// an ERC-20/BEP-2 token is locked on the smart chain side, into a
+// TransferInClaim. recipients/amounts are parallel dynamic arrays so a
+// single lock call can fan a deposit out to several BC receivers; sender is
+// recorded as the refund address for every item in the batch.
+//
+// event LockEvent(address contractAddr, address sender, address[] recipients, uint256[] amounts, uint256 relayFee, uint256 expireTime)
+func decodeLockEvent(data []byte) (*msg.TransferInClaim, error) {
+	words, err := decodeWords(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) < 6 {
+		return nil, fmt.Errorf("LockEvent data too short: %d words", len(words))
+	}
+
+	contractAddress := wordToSmartChainAddress(words[0])
+	sender := wordToSmartChainAddress(words[1])
+
+	recipientsOffset, err := dynamicOffset(words[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipients offset: %v", err)
+	}
+	amountsOffset, err := dynamicOffset(words[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid amounts offset: %v", err)
+	}
+	relayFee := wordToInt64(words[4])
+	expireTime := wordToInt64(words[5])
+
+	recipients, err := decodeAddressArray(data, recipientsOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode recipients: %v", err)
+	}
+	amounts, err := decodeUint256Array(data, amountsOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode amounts: %v", err)
+	}
+	if len(recipients) != len(amounts) {
+		return nil, fmt.Errorf("recipients length %d does not match amounts length %d", len(recipients), len(amounts))
+	}
+
+	receiverAddresses := make([]sdk.AccAddress, len(recipients))
+	refundAddresses := make([]msg.SmartChainAddress, len(recipients))
+	for i, recipient := range recipients {
+		receiverAddresses[i] = sdk.AccAddress(recipient)
+		refundAddresses[i] = sender
+	}
+
+	return &msg.TransferInClaim{
+		ContractAddress:   contractAddress,
+		RefundAddresses:   refundAddresses,
+		ReceiverAddresses: receiverAddresses,
+		Amounts:           amounts,
+		RelayFee:          newBNBCoin(relayFee),
+		ExpireTime:        expireTime,
+	}, nil
+}
+
+// decodeRefundEvent decodes the bridge contract's RefundEvent log, emitted
+// when a TransferIn could not be completed on the smart chain side and the
+// locked amount is refunded back to the originating BC account, into a
+// TransferOutRefundClaim.
+//
+// event RefundEvent(string symbol, address bep2Addr, uint256 amount, uint16 refundReason)
+func decodeRefundEvent(data []byte) (*msg.TransferOutRefundClaim, error) {
+	words, err := decodeWords(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) < 4 {
+		return nil, fmt.Errorf("RefundEvent data too short: %d words", len(words))
+	}
+
+	symbolOffset, err := dynamicOffset(words[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid symbol offset: %v", err)
+	}
+	refundAddress := sdk.AccAddress(words[1][word-20:])
+	amount := wordToInt64(words[2])
+	refundReason := msg.RefundReason(wordToInt64(words[3]))
+
+	symbol, err := decodeString(data, symbolOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode symbol: %v", err)
+	}
+
+	return &msg.TransferOutRefundClaim{
+		RefundAddress: refundAddress,
+		Amount:        sdk.Coin{Denom: symbol, Amount: amount},
+		RefundReason:  refundReason,
+	}, nil
+}
+
+// decodeBindEvent decodes the bridge contract's BindEvent log, emitted when
+// a smart-chain validator acknowledges or rejects a BindMsg, into an
+// UpdateBindClaim.
+//
+// event BindEvent(string symbol, address contractAddr, uint8 status)
+func decodeBindEvent(data []byte) (*msg.UpdateBindClaim, error) {
+	words, err := decodeWords(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) < 3 {
+		return nil, fmt.Errorf("BindEvent data too short: %d words", len(words))
+	}
+
+	symbolOffset, err := dynamicOffset(words[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid symbol offset: %v", err)
+	}
+	contractAddress := wordToSmartChainAddress(words[1])
+	status := msg.BindStatus(wordToInt64(words[2]))
+
+	symbol, err := decodeString(data, symbolOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode symbol: %v", err)
+	}
+
+	return &msg.UpdateBindClaim{
+		Symbol:          symbol,
+		ContractAddress: contractAddress,
+		Status:          status,
+	}, nil
+}
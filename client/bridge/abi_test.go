@@ -0,0 +1,93 @@
+package bridge
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// wordFromInt64 renders v as a single left-padded 32-byte ABI word.
+func wordFromInt64(v int64) []byte {
+	b := make([]byte, word)
+	copy(b[word-len(big.NewInt(v).Bytes()):], big.NewInt(v).Bytes())
+	return b
+}
+
+// wordFromAddress renders a 20-byte address as a single left-padded 32-byte
+// ABI word.
+func wordFromAddress(addr msg.SmartChainAddress) []byte {
+	b := make([]byte, word)
+	copy(b[word-20:], addr[:])
+	return b
+}
+
+func TestDecodeLockEvent(t *testing.T) {
+	contractAddr := msg.NewSmartChainAddress("0x0000000000000000000000000000000000000001")
+	sender := msg.NewSmartChainAddress("0x0000000000000000000000000000000000000002")
+	recipient1 := msg.NewSmartChainAddress("0x0000000000000000000000000000000000000003")
+	recipient2 := msg.NewSmartChainAddress("0x0000000000000000000000000000000000000004")
+
+	// Head: 6 static/offset words, so the tail starts at byte 6*32=192.
+	recipientsOffset := int64(6 * word)
+	var recipientsTail bytes.Buffer
+	recipientsTail.Write(wordFromInt64(2)) // length
+	recipientsTail.Write(wordFromAddress(recipient1))
+	recipientsTail.Write(wordFromAddress(recipient2))
+
+	amountsOffset := recipientsOffset + int64(recipientsTail.Len())
+	var amountsTail bytes.Buffer
+	amountsTail.Write(wordFromInt64(2)) // length
+	amountsTail.Write(wordFromInt64(1000))
+	amountsTail.Write(wordFromInt64(2000))
+
+	var data bytes.Buffer
+	data.Write(wordFromAddress(contractAddr))
+	data.Write(wordFromAddress(sender))
+	data.Write(wordFromInt64(recipientsOffset))
+	data.Write(wordFromInt64(amountsOffset))
+	data.Write(wordFromInt64(50)) // relayFee
+	data.Write(wordFromInt64(9999999999))
+	data.Write(recipientsTail.Bytes())
+	data.Write(amountsTail.Bytes())
+
+	claim, err := decodeLockEvent(data.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, contractAddr, claim.ContractAddress)
+	require.Equal(t, []int64{1000, 2000}, claim.Amounts)
+	require.Len(t, claim.ReceiverAddresses, 2)
+	require.Len(t, claim.RefundAddresses, 2)
+	require.Equal(t, sender, claim.RefundAddresses[0])
+	require.Equal(t, sender, claim.RefundAddresses[1])
+	require.Equal(t, recipient1[:], []byte(claim.ReceiverAddresses[0]))
+	require.Equal(t, recipient2[:], []byte(claim.ReceiverAddresses[1]))
+	require.Equal(t, int64(50), claim.RelayFee.Amount)
+	require.Equal(t, int64(9999999999), claim.ExpireTime)
+}
+
+func TestDecodeBindEvent(t *testing.T) {
+	contractAddr := msg.NewSmartChainAddress("0x0000000000000000000000000000000000000005")
+	symbol := "BTC-85A"
+
+	symbolOffset := int64(3 * word)
+	var symbolTail bytes.Buffer
+	symbolTail.Write(wordFromInt64(int64(len(symbol))))
+	padded := make([]byte, ((len(symbol)+word-1)/word)*word)
+	copy(padded, symbol)
+	symbolTail.Write(padded)
+
+	var data bytes.Buffer
+	data.Write(wordFromInt64(symbolOffset))
+	data.Write(wordFromAddress(contractAddr))
+	data.Write(wordFromInt64(int64(msg.BindStatusSuccess)))
+	data.Write(symbolTail.Bytes())
+
+	claim, err := decodeBindEvent(data.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, symbol, claim.Symbol)
+	require.Equal(t, contractAddr, claim.ContractAddress)
+	require.Equal(t, msg.BindStatusSuccess, claim.Status)
+}
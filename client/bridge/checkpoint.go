@@ -0,0 +1,98 @@
+package bridge
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// CheckpointStore persists the last smart-chain block a Relayer has fully
+// processed, so a restarted relayer can resume instead of re-scanning from
+// genesis or missing a gap.
+type CheckpointStore interface {
+	LastProcessedBlock() (int64, error)
+	SaveLastProcessedBlock(height int64) error
+}
+
+// FileCheckpointStore stores the checkpoint as a single plaintext height in
+// a file, suitable for single-instance relayers that don't want a database
+// dependency.
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns a CheckpointStore backed by the file at
+// path. The file is created lazily on the first SaveLastProcessedBlock
+// call.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (s *FileCheckpointStore) LastProcessedBlock() (int64, error) {
+	content, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseInt(string(content), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("corrupt checkpoint file %s: %v", s.path, err)
+	}
+	return height, nil
+}
+
+func (s *FileCheckpointStore) SaveLastProcessedBlock(height int64) error {
+	return ioutil.WriteFile(s.path, []byte(strconv.FormatInt(height, 10)), 0644)
+}
+
+// levelDBCheckpointKey is the single key under which the last processed
+// block height is stored; one LevelDBCheckpointStore tracks one chain.
+var levelDBCheckpointKey = []byte("bridge/last_processed_block")
+
+// LevelDBCheckpointStore stores the checkpoint in a leveldb database,
+// suitable for relayers that already keep other state (e.g. seen claim
+// dedup sets) in leveldb and want a single storage engine.
+type LevelDBCheckpointStore struct {
+	db *leveldb.DB
+}
+
+// NewLevelDBCheckpointStore opens (creating if necessary) a leveldb
+// database at path to use as a CheckpointStore.
+func NewLevelDBCheckpointStore(path string) (*LevelDBCheckpointStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LevelDBCheckpointStore{db: db}, nil
+}
+
+func (s *LevelDBCheckpointStore) LastProcessedBlock() (int64, error) {
+	value, err := s.db.Get(levelDBCheckpointKey, nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("corrupt checkpoint value: %v", err)
+	}
+	return height, nil
+}
+
+func (s *LevelDBCheckpointStore) SaveLastProcessedBlock(height int64) error {
+	return s.db.Put(levelDBCheckpointKey, []byte(strconv.FormatInt(height, 10)), nil)
+}
+
+// Close releases the underlying leveldb handle.
+func (s *LevelDBCheckpointStore) Close() error {
+	return s.db.Close()
+}
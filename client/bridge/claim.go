@@ -0,0 +1,26 @@
+package bridge
+
+import (
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// ClaimEvent wraps a decoded smart-chain bridge log together with the block
+// it was observed in, so a Relayer can both submit it as an oracle claim
+// and track how far it has progressed through the chain.
+//
+// *msg.SkipSequenceClaim is not produced here: it has no corresponding
+// contract log and is instead submitted directly via
+// Relayer.SubmitSkipSequence when the relayer itself decides a sequence
+// must be skipped (e.g. after exhausting retries on a claim the chain
+// keeps rejecting), rather than being observed from the smart chain.
+type ClaimEvent struct {
+	ClaimType   msg.ClaimType
+	BlockHeight int64
+	// Sequence is this claim type's oracle sequence number, assigned in
+	// Relayer.SubmitClaim right before broadcast.
+	Sequence int64
+	// Claim holds the decoded claim payload: one of *msg.TransferInClaim,
+	// *msg.UpdateBindClaim or *msg.TransferOutRefundClaim, matching
+	// ClaimType.
+	Claim interface{}
+}
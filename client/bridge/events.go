@@ -0,0 +1,64 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// Event topic0 hashes, i.e. keccak256 of the event signature, as emitted by
+// the bridge contract. Computed offline; see the contract's ABI for the
+// canonical event signatures.
+const (
+	lockEventTopic   = "0x2f0573d1f019871f8ecf7a90d789513e5c262be6600579168f973abd4c6b15ad"
+	bindEventTopic   = "0x393d88a94cc23c137a17b95b8884c20868795c64d46e94a6083c960bca646740"
+	refundEventTopic = "0xcfd475a4e2a236c446b9a2223b758a76656ef16e582edd39937343fd60048d60"
+)
+
+// decodeLog decodes a raw eth_subscribe/eth_getLogs log entry into a
+// ClaimEvent, dispatching on the log's topic to the matching ABI decoder.
+func decodeLog(raw json.RawMessage) (ClaimEvent, int64, error) {
+	var rawLog struct {
+		Topics      []string `json:"topics"`
+		Data        string   `json:"data"`
+		BlockNumber string   `json:"blockNumber"`
+	}
+	if err := json.Unmarshal(raw, &rawLog); err != nil {
+		return ClaimEvent{}, 0, err
+	}
+
+	if len(rawLog.Topics) == 0 {
+		return ClaimEvent{}, 0, fmt.Errorf("log has no topics")
+	}
+
+	data, err := msg.HexDecode(rawLog.Data)
+	if err != nil {
+		return ClaimEvent{}, 0, err
+	}
+
+	height := hexToInt64(rawLog.BlockNumber)
+
+	switch rawLog.Topics[0] {
+	case lockEventTopic:
+		claim, err := decodeLockEvent(data)
+		if err != nil {
+			return ClaimEvent{}, 0, err
+		}
+		return ClaimEvent{ClaimType: msg.ClaimTypeTransferIn, BlockHeight: height, Claim: claim}, height, nil
+	case bindEventTopic:
+		claim, err := decodeBindEvent(data)
+		if err != nil {
+			return ClaimEvent{}, 0, err
+		}
+		return ClaimEvent{ClaimType: msg.ClaimTypeUpdateBind, BlockHeight: height, Claim: claim}, height, nil
+	case refundEventTopic:
+		claim, err := decodeRefundEvent(data)
+		if err != nil {
+			return ClaimEvent{}, 0, err
+		}
+		return ClaimEvent{ClaimType: msg.ClaimTypeTransferOutRefund, BlockHeight: height, Claim: claim}, height, nil
+	default:
+		return ClaimEvent{}, 0, fmt.Errorf("unrecognized event topic %s", rawLog.Topics[0])
+	}
+}
@@ -0,0 +1,44 @@
+package bridge
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metrics holds the Prometheus instrumentation a Relayer reports so an
+// operator can alert on a stuck or lagging bridge oracle instead of
+// discovering it from missed claims downstream.
+type metrics struct {
+	claimLatency prometheus.Histogram
+	claimRetries prometheus.Counter
+	sequenceGaps prometheus.Counter
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		claimLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "bridge",
+			Subsystem: "relayer",
+			Name:      "claim_latency_seconds",
+			Help:      "Time spent in each SubmitClaim call, from sequence lookup through broadcast.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		claimRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bridge",
+			Subsystem: "relayer",
+			Name:      "claim_retries_total",
+			Help:      "Number of claim and skip-sequence broadcasts that failed with a submission error; the relayer does not retry them itself.",
+		}),
+		sequenceGaps: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "bridge",
+			Subsystem: "relayer",
+			Name:      "sequence_gaps_total",
+			Help:      "Number of times a claim was observed with a sequence higher than expected.",
+		}),
+	}
+}
+
+// Collectors returns the metrics in a form ready to pass to
+// prometheus.Registry.MustRegister.
+func (m *metrics) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.claimLatency, m.claimRetries, m.sequenceGaps}
+}
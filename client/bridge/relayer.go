@@ -0,0 +1,250 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/binance-chain/go-sdk/keys"
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// pollInterval is how often WatchSmartChain falls back to eth_getLogs when
+// the RPC endpoint does not support eth_subscribe (e.g. an HTTP-only node).
+const pollInterval = 3 * time.Second
+
+// Broadcaster is the subset of the SDK's existing tx broadcasting path a
+// Relayer needs in order to submit oracle claims; *client.BasicClient
+// satisfies it.
+type Broadcaster interface {
+	SendMsg(km keys.KeyManager, message msg.Msg) error
+}
+
+// Relayer watches a smart chain for bridge contract events and submits them
+// to Binance Chain as oracle claims, closing the loop opened by BindMsg and
+// TransferOutMsg on the BC side.
+type Relayer interface {
+	// WatchSmartChain streams decoded bridge events from contract starting
+	// after the relayer's last checkpoint until ctx is cancelled. Over
+	// eth_subscribe only logs emitted after the subscription is made are
+	// streamed: blocks between the checkpoint and that moment are not
+	// backfilled, and the channel also closes if the connection fails.
+	WatchSmartChain(ctx context.Context, rpcURL string, contract msg.SmartChainAddress) (<-chan ClaimEvent, error)
+	// SubmitClaim broadcasts event as the corresponding oracle claim
+	// message, signed by km.
+	SubmitClaim(km keys.KeyManager, event ClaimEvent) error
+	// SubmitSkipSequence broadcasts a SkipSequenceClaim telling the oracle
+	// module to advance skippedType past sequence. Unlike other claim
+	// types this has no corresponding contract log; callers invoke it
+	// directly once they've decided a sequence cannot be claimed (e.g. a
+	// claim the chain keeps rejecting after repeated retries).
+	SubmitSkipSequence(km keys.KeyManager, skippedType msg.ClaimType, sequence int64) error
+}
+
+// relayer is the default Relayer implementation. It is resumable across
+// restarts via checkpoints and reports Prometheus metrics for operators.
+type relayer struct {
+	checkpoints CheckpointStore
+	broadcaster Broadcaster
+	sequences   *sequenceTracker
+	metrics     *metrics
+}
+
+// NewRelayer returns a Relayer that resumes scanning from checkpoints,
+// submits claims through broadcaster, assigns claim sequence numbers (and
+// detects gaps in them) through sequences, and reports claim latency,
+// retries and sequence gaps to Prometheus. Register Collectors() with the
+// caller's own registry to expose them.
+func NewRelayer(checkpoints CheckpointStore, broadcaster Broadcaster, sequences SequenceQuerier) Relayer {
+	return &relayer{
+		checkpoints: checkpoints,
+		broadcaster: broadcaster,
+		sequences:   newSequenceTracker(sequences),
+		metrics:     newMetrics(),
+	}
+}
+
+// Collectors exposes the relayer's Prometheus collectors so callers can
+// register them with their own registry.
+func (r *relayer) Collectors() []prometheus.Collector {
+	return r.metrics.Collectors()
+}
+
+func (r *relayer) WatchSmartChain(ctx context.Context, rpcURL string, contract msg.SmartChainAddress) (<-chan ClaimEvent, error) {
+	fromBlock, err := r.checkpoints.LastProcessedBlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoint: %v", err)
+	}
+
+	events := make(chan ClaimEvent)
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, rpcURL, nil)
+	if err != nil {
+		// Fall back to polling eth_getLogs for HTTP-only endpoints rather
+		// than failing outright, since many smart-chain RPC providers
+		// don't expose eth_subscribe.
+		go r.pollLogs(ctx, rpcURL, contract, fromBlock, events)
+		return events, nil
+	}
+
+	go r.subscribeLogs(ctx, conn, contract, fromBlock, events)
+	return events, nil
+}
+
+func (r *relayer) subscribeLogs(ctx context.Context, conn *websocket.Conn, contract msg.SmartChainAddress, fromBlock int64, events chan<- ClaimEvent) {
+	defer close(events)
+
+	// conn.ReadJSON below blocks until a message arrives and does not
+	// observe ctx on its own, so closing conn is how cancellation actually
+	// unblocks the read loop.
+	closed := make(chan struct{})
+	defer close(closed)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-closed:
+		}
+	}()
+	defer conn.Close()
+
+	subscribeReq := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_subscribe",
+		"params":  []interface{}{"logs", map[string]interface{}{"address": contract.String()}},
+	}
+	if err := conn.WriteJSON(subscribeReq); err != nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		var notification struct {
+			Params struct {
+				Result json.RawMessage `json:"result"`
+			} `json:"params"`
+		}
+		if err := conn.ReadJSON(&notification); err != nil {
+			return
+		}
+		if len(notification.Params.Result) == 0 {
+			continue
+		}
+
+		event, height, err := decodeLog(notification.Params.Result)
+		if err != nil {
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return
+		}
+
+		if height > fromBlock {
+			// A log from a newer block means every earlier block has been
+			// delivered in full; this block may still have logs to come, so
+			// the checkpoint stops one block short of it.
+			fromBlock = height
+			_ = r.checkpoints.SaveLastProcessedBlock(fromBlock - 1)
+		}
+	}
+}
+
+// pollLogs is the eth_getLogs fallback used when the endpoint doesn't
+// support eth_subscribe. It is intentionally simple: poll, decode, advance
+// the checkpoint, sleep.
+func (r *relayer) pollLogs(ctx context.Context, rpcURL string, contract msg.SmartChainAddress, fromBlock int64, events chan<- ClaimEvent) {
+	defer close(events)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// fromBlock has already been fully processed and eth_getLogs
+			// treats its fromBlock as inclusive, so the query starts one
+			// block past it.
+			logs, latest, err := fetchLogs(ctx, rpcURL, contract, fromBlock+1)
+			if err != nil {
+				continue
+			}
+
+			for _, rawLog := range logs {
+				event, height, err := decodeLog(rawLog)
+				if err != nil {
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+
+				if height > fromBlock {
+					fromBlock = height
+				}
+			}
+
+			if len(logs) > 0 && latest > fromBlock {
+				fromBlock = latest
+			}
+			_ = r.checkpoints.SaveLastProcessedBlock(fromBlock)
+		}
+	}
+}
+
+func (r *relayer) SubmitClaim(km keys.KeyManager, event ClaimEvent) error {
+	start := time.Now()
+	defer func() { r.metrics.claimLatency.Observe(time.Since(start).Seconds()) }()
+
+	sequence, gap, err := r.sequences.next(event.ClaimType)
+	if err != nil {
+		return fmt.Errorf("failed to determine claim sequence: %v", err)
+	}
+	if gap {
+		r.metrics.sequenceGaps.Inc()
+	}
+	event.Sequence = sequence
+
+	claimMsg, err := buildClaimMsg(km, event)
+	if err != nil {
+		return err
+	}
+
+	if err := r.broadcaster.SendMsg(km, claimMsg); err != nil {
+		r.metrics.claimRetries.Inc()
+		return fmt.Errorf("failed to submit claim: %v", err)
+	}
+
+	return nil
+}
+
+func (r *relayer) SubmitSkipSequence(km keys.KeyManager, skippedType msg.ClaimType, sequence int64) error {
+	skipMsg, err := buildSkipSequenceClaimMsg(km, skippedType, sequence)
+	if err != nil {
+		return err
+	}
+
+	if err := r.broadcaster.SendMsg(km, skipMsg); err != nil {
+		r.metrics.claimRetries.Inc()
+		return fmt.Errorf("failed to submit skip-sequence claim: %v", err)
+	}
+
+	return nil
+}
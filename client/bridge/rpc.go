@@ -0,0 +1,86 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// hexToInt64 parses a "0x..."-prefixed quantity as returned by JSON-RPC into
+// an int64, returning 0 for malformed input since block heights are
+// advisory for the poll loop's checkpointing, not correctness-critical.
+func hexToInt64(hex string) int64 {
+	hex = strings.TrimPrefix(hex, "0x")
+	height, err := strconv.ParseInt(hex, 16, 64)
+	if err != nil {
+		return 0
+	}
+	return height
+}
+
+// fetchLogs calls eth_getLogs on rpcURL for contract's logs from fromBlock
+// to "latest", returning the raw log entries and the highest block number
+// among them (fromBlock when there are none). The chain head itself is not
+// queried, so pollLogs's checkpoint only advances when a log is returned.
+// The request is bound to ctx so a cancellation interrupts an
+// in-flight call instead of leaving pollLogs blocked until it completes.
+func fetchLogs(ctx context.Context, rpcURL string, contract msg.SmartChainAddress, fromBlock int64) ([]json.RawMessage, int64, error) {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_getLogs",
+		"params": []interface{}{map[string]interface{}{
+			"address":   contract.String(),
+			"fromBlock": fmt.Sprintf("0x%x", fromBlock),
+			"toBlock":   "latest",
+		}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Result []json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, 0, err
+	}
+	if result.Error != nil {
+		return nil, 0, fmt.Errorf("eth_getLogs failed: %s", result.Error.Message)
+	}
+
+	latest := fromBlock
+	for _, rawLog := range result.Result {
+		var entry struct {
+			BlockNumber string `json:"blockNumber"`
+		}
+		if err := json.Unmarshal(rawLog, &entry); err == nil {
+			if height := hexToInt64(entry.BlockNumber); height > latest {
+				latest = height
+			}
+		}
+	}
+
+	return result.Result, latest, nil
+}
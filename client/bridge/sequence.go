@@ -0,0 +1,54 @@
+package bridge
+
+import (
+	"sync"
+
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// SequenceQuerier is the subset of the SDK's existing oracle query path a
+// Relayer needs in order to assign claim sequence numbers and detect gaps;
+// *client.BasicClient's oracle query methods satisfy it.
+type SequenceQuerier interface {
+	// CurrentSequence returns the oracle module's current on-chain sequence
+	// number for claimType, i.e. the sequence the next claim of that type
+	// is expected to use.
+	CurrentSequence(claimType msg.ClaimType) (int64, error)
+}
+
+// sequenceTracker assigns each outgoing claim the oracle's current sequence
+// number for its claim type, and flags sequence_gaps whenever the chain's
+// sequence has advanced further than this relayer last observed, which
+// means another relayer instance (or a skip) claimed one in between.
+type sequenceTracker struct {
+	querier SequenceQuerier
+
+	mu       sync.Mutex
+	expected map[msg.ClaimType]int64
+}
+
+func newSequenceTracker(querier SequenceQuerier) *sequenceTracker {
+	return &sequenceTracker{
+		querier:  querier,
+		expected: make(map[msg.ClaimType]int64),
+	}
+}
+
+// next returns the sequence number claimType's next claim should use,
+// reporting a gap if the on-chain sequence has moved past what this
+// relayer last expected.
+func (t *sequenceTracker) next(claimType msg.ClaimType) (int64, bool, error) {
+	onChain, err := t.querier.CurrentSequence(claimType)
+	if err != nil {
+		return 0, false, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	expected, seen := t.expected[claimType]
+	gap := seen && onChain > expected
+	t.expected[claimType] = onChain + 1
+
+	return onChain, gap, nil
+}
@@ -0,0 +1,33 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/binance-chain/go-sdk/keys"
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// buildClaimMsg marshals event's decoded claim payload and wraps it in the
+// oracle ClaimMsg the existing tx broadcasting path expects, signed as km's
+// validator.
+func buildClaimMsg(km keys.KeyManager, event ClaimEvent) (msg.Msg, error) {
+	claimJSON, err := json.Marshal(event.Claim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal claim payload: %v", err)
+	}
+
+	return msg.NewClaimMsg(km.GetAddr(), event.ClaimType, event.Sequence, string(claimJSON)), nil
+}
+
+// buildSkipSequenceClaimMsg wraps a SkipSequenceClaim for skippedType/
+// sequence in the same oracle ClaimMsg envelope buildClaimMsg uses, since
+// SkipSequenceClaim has no decoded contract log to carry it.
+func buildSkipSequenceClaimMsg(km keys.KeyManager, skippedType msg.ClaimType, sequence int64) (msg.Msg, error) {
+	claimJSON, err := json.Marshal(msg.SkipSequenceClaim{ClaimType: skippedType, Sequence: sequence})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal skip-sequence claim payload: %v", err)
+	}
+
+	return msg.NewClaimMsg(km.GetAddr(), msg.ClaimTypeSkipSequence, sequence, string(claimJSON)), nil
+}
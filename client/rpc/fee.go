@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"fmt"
+
+	sdk "github.com/binance-chain/go-sdk/common/types"
+	"github.com/binance-chain/go-sdk/keys"
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// BridgeParams mirrors the bridge module's on-chain fee parameters that
+// gate cross-chain messages.
+type BridgeParams struct {
+	BindFee  sdk.Coin
+	RelayFee sdk.Coin
+}
+
+// BridgeParamsQuerier is the subset of the SDK's existing chain query path
+// EstimateCrossChainFee needs to read the bridge module's fee parameters;
+// *Client satisfies it.
+type BridgeParamsQuerier interface {
+	QueryBridgeParams() (BridgeParams, error)
+}
+
+// BalanceQuerier is the subset of the SDK's existing chain query path a
+// cross-chain transaction builder needs to validate a user's balance
+// before signing; *Client satisfies it.
+type BalanceQuerier interface {
+	QueryBalance(addr sdk.AccAddress) ([]sdk.Coin, error)
+}
+
+// InsufficientFeeError is returned when a user's balance does not cover the
+// fee a cross-chain message requires, naming the missing coin so the
+// caller learns this before broadcasting rather than from an opaque
+// on-chain error code.
+type InsufficientFeeError struct {
+	Required sdk.Coin
+	Balance  int64
+}
+
+func (e InsufficientFeeError) Error() string {
+	return fmt.Sprintf("insufficient balance for cross-chain fee: need %s, have %d %s", e.Required.String(), e.Balance, e.Required.Denom)
+}
+
+// EstimateCrossChainFee queries the chain's bridge parameters and returns
+// the total BNB cost a user must attach to message, a BindMsg or
+// TransferOutMsg, in order for it to be accepted.
+func EstimateCrossChainFee(querier BridgeParamsQuerier, message msg.Msg) (sdk.Coin, error) {
+	params, err := querier.QueryBridgeParams()
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to query bridge params: %v", err)
+	}
+
+	switch message.(type) {
+	case msg.BindMsg:
+		return params.BindFee, nil
+	case msg.TransferOutMsg:
+		return params.RelayFee, nil
+	default:
+		return sdk.Coin{}, fmt.Errorf("unsupported cross-chain message type %T", message)
+	}
+}
+
+// PrepareCrossChainTransferOut attaches the current RelayFee to out as a
+// separate fee coin, and validates that km's account holds enough of that
+// coin before the caller signs, so a wrong fee is caught locally instead of
+// surfacing as an opaque on-chain error code.
+func PrepareCrossChainTransferOut(params BridgeParamsQuerier, balances BalanceQuerier, km keys.KeyManager, out msg.TransferOutMsg) (msg.TransferOutMsg, sdk.Coin, error) {
+	fee, err := EstimateCrossChainFee(params, out)
+	if err != nil {
+		return out, sdk.Coin{}, err
+	}
+
+	coins, err := balances.QueryBalance(km.GetAddr())
+	if err != nil {
+		return out, sdk.Coin{}, fmt.Errorf("failed to query balance: %v", err)
+	}
+
+	// out.Amount is commonly denominated in the same coin as the relay
+	// fee (BNB), in which case the wallet needs both the fee and the
+	// transfer amount covered out of the same balance, not just the fee.
+	required := fee.Amount
+	if out.Amount.Denom == fee.Denom {
+		required += out.Amount.Amount
+	}
+
+	var available int64
+	for _, coin := range coins {
+		if coin.Denom == fee.Denom {
+			available = coin.Amount
+			break
+		}
+	}
+	if available < required {
+		return out, sdk.Coin{}, InsufficientFeeError{Required: sdk.Coin{Denom: fee.Denom, Amount: required}, Balance: available}
+	}
+
+	return out, fee, nil
+}
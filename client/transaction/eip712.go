@@ -0,0 +1,32 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/binance-chain/go-sdk/keys"
+	"github.com/binance-chain/go-sdk/types/msg"
+)
+
+// SignTransferOutEIP712 builds the EIP-712 typed-data digest for a
+// TransferOutMsg and signs it with km, letting a user holding an EVM-native
+// key (e.g. a Ledger Ethereum app or MetaMask) co-sign a cross-chain
+// transfer without Binance-Chain-specific firmware. It returns the digest
+// that was signed alongside the raw signature so callers can submit both
+// to a relayer or verify them independently.
+func SignTransferOutEIP712(km keys.KeyManager, transferOut msg.TransferOutMsg, chainID *big.Int, verifyingContract msg.SmartChainAddress) (digest []byte, signature []byte, err error) {
+	digest, err = transferOut.GetEIP712SignBytes(chainID, verifyingContract)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// EIP-712 digests must be signed as-is, unlike the amino sign path
+	// which hashes a StdSignMsg first, so this signs the raw digest
+	// directly with the underlying key rather than going through a
+	// Binance-Chain-specific sign method.
+	signature, err = km.GetPrivKey().Sign(digest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return digest, signature, nil
+}
@@ -0,0 +1,125 @@
+package msg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// CanonicalSignBytes produces the canonical sign bytes for msg: object keys
+// sorted lexicographically, numbers rendered as plain integers rather than
+// Go's default float formatting, and zero-valued object fields (nil, false,
+// 0, "", empty array) omitted regardless of struct tags. json.Marshal alone
+// does not guarantee key ordering for embedded maps, can emit numbers in
+// scientific notation, and always includes zero-valued fields absent an
+// explicit omitempty tag, producing output that diverges from the
+// amino-sorted JSON the chain actually verifies signatures against.
+// GetSignBytes implementations in this package must route through this
+// function rather than calling json.Marshal directly.
+func CanonicalSignBytes(msg Msg) ([]byte, error) {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T for canonical sign bytes: %v", msg, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to decode %T for canonical sign bytes: %v", msg, err)
+	}
+
+	var buf bytes.Buffer
+	if err := encodeCanonical(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeCanonical re-encodes a decoded JSON value with object keys sorted
+// lexicographically, recursing into nested objects and arrays.
+func encodeCanonical(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		wrote := false
+		for _, k := range keys {
+			if isZeroJSONValue(v[k]) {
+				continue
+			}
+			if wrote {
+				buf.WriteByte(',')
+			}
+			keyBytes, err := json.Marshal(k)
+			if err != nil {
+				return err
+			}
+			buf.Write(keyBytes)
+			buf.WriteByte(':')
+			if err := encodeCanonical(buf, v[k]); err != nil {
+				return err
+			}
+			wrote = true
+		}
+		buf.WriteByte('}')
+		return nil
+
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeCanonical(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case json.Number:
+		// json.Number preserves the original digit sequence, so integer
+		// fields such as coin amounts never round-trip through scientific
+		// notation the way a decoded float64 would.
+		buf.WriteString(v.String())
+		return nil
+
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+		return nil
+	}
+}
+
+// isZeroJSONValue reports whether a decoded JSON value is its type's zero
+// value (nil, false, the number 0, the empty string, or an empty array),
+// the fields CanonicalSignBytes omits from object output. Objects are never
+// considered zero: an embedded struct field stays even when every field it
+// contains is itself zero.
+func isZeroJSONValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case json.Number:
+		return v.String() == "0"
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
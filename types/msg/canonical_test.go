@@ -0,0 +1,104 @@
+package msg
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/binance-chain/go-sdk/common/types"
+)
+
+// marshalCanonical runs v through the same marshal/decode/encodeCanonical
+// pipeline CanonicalSignBytes uses, without requiring v to implement Msg.
+// This lets the encoder itself be golden-vector tested independently of
+// any particular message type.
+func marshalCanonical(t *testing.T, v interface{}) string {
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	require.NoError(t, decoder.Decode(&value))
+
+	var buf bytes.Buffer
+	require.NoError(t, encodeCanonical(&buf, value))
+	return buf.String()
+}
+
+func TestEncodeCanonicalSortsKeysAndOmitsZeroFields(t *testing.T) {
+	fixture := struct {
+		Zebra  int64  `json:"zebra"`
+		Apple  string `json:"apple"`
+		Empty  string `json:"empty"`
+		Zero   int64  `json:"zero"`
+		Flag   bool   `json:"flag"`
+		Nested struct {
+			Z int64 `json:"z"`
+			A int64 `json:"a"`
+		} `json:"nested"`
+	}{
+		Zebra: 7,
+		Apple: "fruit",
+	}
+	fixture.Nested.Z = 2
+	fixture.Nested.A = 1
+
+	got := marshalCanonical(t, fixture)
+	require.Equal(t, `{"apple":"fruit","nested":{"a":1,"z":2},"zebra":7}`, got)
+}
+
+func TestEncodeCanonicalRendersLargeIntegersWithoutScientificNotation(t *testing.T) {
+	fixture := struct {
+		Amount int64 `json:"amount"`
+	}{Amount: 100000000000000000}
+
+	got := marshalCanonical(t, fixture)
+	require.Equal(t, `{"amount":100000000000000000}`, got)
+	require.NotContains(t, got, "e+")
+}
+
+// TestBindMsgGetSignBytesKeyOrderAndOmission is a golden-vector-style check
+// against BindMsg.GetSignBytes: it doesn't assert a byte-exact fixture
+// captured from a live node (sdk.AccAddress's bech32 rendering is outside
+// this package), but it does pin the properties the chain's verifier
+// depends on: lexicographically sorted top-level keys and omission of the
+// zero-valued ContractDecimals field.
+func TestBindMsgGetSignBytesKeyOrderAndOmission(t *testing.T) {
+	bindMsg := NewBindMsg(make(sdk.AccAddress, sdk.AddrLen), "BTC-85A", 100000000, NewSmartChainAddress("0x0000000000000000000000000000000000000001"), 0, 1732000000)
+
+	signBytes := bindMsg.GetSignBytes()
+	got := string(signBytes)
+
+	require.False(t, strings.Contains(got, `"contract_decimals"`), "zero-valued contract_decimals should be omitted: %s", got)
+
+	keys := []string{`"amount"`, `"contract_address"`, `"expire_time"`, `"from"`, `"symbol"`}
+	lastIndex := -1
+	for _, key := range keys {
+		index := strings.Index(got, key)
+		require.GreaterOrEqual(t, index, 0, "expected key %s in %s", key, got)
+		require.Greater(t, index, lastIndex, "key %s out of lexicographic order in %s", key, got)
+		lastIndex = index
+	}
+}
+
+// TestTransferOutMsgGetSignBytesKeyOrder pins the same sorted-key property
+// for TransferOutMsg.GetSignBytes.
+func TestTransferOutMsgGetSignBytesKeyOrder(t *testing.T) {
+	transferOut := NewTransferOutMsg(make(sdk.AccAddress, sdk.AddrLen), NewSmartChainAddress("0x0000000000000000000000000000000000000002"), sdk.Coin{Denom: "BNB", Amount: 100000000}, 1732000000)
+
+	signBytes := transferOut.GetSignBytes()
+	got := string(signBytes)
+
+	keys := []string{`"amount"`, `"expire_time"`, `"from"`, `"to"`}
+	lastIndex := -1
+	for _, key := range keys {
+		index := strings.Index(got, key)
+		require.GreaterOrEqual(t, index, 0, "expected key %s in %s", key, got)
+		require.Greater(t, index, lastIndex, "key %s out of lexicographic order in %s", key, got)
+		lastIndex = index
+	}
+}
@@ -0,0 +1,110 @@
+package msg
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// bep2Decimals is the fixed number of decimals BEP2 tokens use on Binance
+// Chain; ScaleAmount/DescaleAmount convert between this and a bound
+// contract's own decimals on the smart chain.
+const bep2Decimals = 8
+
+// maxContractDecimals is the largest ERC-20 decimals value a bind will
+// accept. 18 covers every contract seen in practice (WETH, most ERC-20s)
+// while keeping the scaled uint256 comfortably below overflow for realistic
+// BEP2 amounts.
+const maxContractDecimals = 18
+
+// ErrAmountOverflow is returned when scaling a BEP2 amount to a contract's
+// decimals would not fit in a uint256, or descaling a uint256 amount would
+// not fit in an int64. Relayers should reject the originating message
+// before it reaches consensus rather than let it fail on-chain.
+type ErrAmountOverflow struct {
+	Amount           string
+	ContractDecimals int8
+}
+
+func (e ErrAmountOverflow) Error() string {
+	return fmt.Sprintf("amount %s overflows when scaled to contract decimals %d", e.Amount, e.ContractDecimals)
+}
+
+// ErrPrecisionLoss is returned when scaling an amount down to fewer
+// decimals would silently drop non-zero low-order digits. Relayers should
+// reject the originating message before it reaches consensus rather than
+// let a truncated amount go through.
+type ErrPrecisionLoss struct {
+	Amount           string
+	ContractDecimals int8
+}
+
+func (e ErrPrecisionLoss) Error() string {
+	return fmt.Sprintf("amount %s cannot be scaled to contract decimals %d without losing precision", e.Amount, e.ContractDecimals)
+}
+
+// uint256Max is the largest value representable in a uint256, the upper
+// bound ERC-20 transfer amounts on the smart chain side must respect.
+var uint256Max = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// ScaleAmount converts a BEP2 8-decimal integer amount into the uint256
+// amount the bound ERC-20 contract expects, scaling by
+// 10^(contractDecimals-8). It returns ErrAmountOverflow if the scaled
+// amount would not fit in a uint256, and ErrPrecisionLoss if scaling down
+// (contractDecimals < 8) would silently drop non-zero low-order digits.
+func ScaleAmount(bcAmount int64, contractDecimals int8) (*big.Int, error) {
+	if bcAmount < 0 {
+		return nil, fmt.Errorf("amount should not be negative")
+	}
+	if contractDecimals < 0 || contractDecimals > maxContractDecimals {
+		return nil, fmt.Errorf("contract decimals should be between 0 and %d", maxContractDecimals)
+	}
+
+	amount := big.NewInt(bcAmount)
+	diff := int(contractDecimals) - bep2Decimals
+	if diff >= 0 {
+		scaled := new(big.Int).Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(diff)), nil))
+		if scaled.Cmp(uint256Max) > 0 {
+			return nil, ErrAmountOverflow{Amount: amount.String(), ContractDecimals: contractDecimals}
+		}
+		return scaled, nil
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-diff)), nil)
+	scaled, remainder := new(big.Int).QuoRem(amount, divisor, new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, ErrPrecisionLoss{Amount: amount.String(), ContractDecimals: contractDecimals}
+	}
+	return scaled, nil
+}
+
+// DescaleAmount converts a uint256 amount from a bound ERC-20 contract back
+// into a BEP2 8-decimal integer amount, scaling by 10^(8-contractDecimals).
+// It returns ErrAmountOverflow if the descaled amount would not fit in an
+// int64, and ErrPrecisionLoss if scaling down (contractDecimals > 8) would
+// silently drop non-zero low-order digits.
+func DescaleAmount(contractAmount *big.Int, contractDecimals int8) (int64, error) {
+	if contractAmount == nil || contractAmount.Sign() < 0 {
+		return 0, fmt.Errorf("amount should not be negative")
+	}
+	if contractDecimals < 0 || contractDecimals > maxContractDecimals {
+		return 0, fmt.Errorf("contract decimals should be between 0 and %d", maxContractDecimals)
+	}
+
+	diff := bep2Decimals - int(contractDecimals)
+	var descaled *big.Int
+	if diff >= 0 {
+		descaled = new(big.Int).Mul(contractAmount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(diff)), nil))
+	} else {
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-diff)), nil)
+		var remainder *big.Int
+		descaled, remainder = new(big.Int).QuoRem(contractAmount, divisor, new(big.Int))
+		if remainder.Sign() != 0 {
+			return 0, ErrPrecisionLoss{Amount: contractAmount.String(), ContractDecimals: contractDecimals}
+		}
+	}
+
+	if !descaled.IsInt64() {
+		return 0, ErrAmountOverflow{Amount: contractAmount.String(), ContractDecimals: contractDecimals}
+	}
+	return descaled.Int64(), nil
+}
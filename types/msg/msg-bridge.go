@@ -1,7 +1,6 @@
 package msg
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -151,8 +150,12 @@ func (msg BindMsg) ValidateBasic() error {
 		return fmt.Errorf("contract address should not be empty")
 	}
 
-	if msg.ContractDecimals < 0 {
-		return fmt.Errorf("decimal should be no less than 0")
+	if msg.ContractDecimals < 0 || msg.ContractDecimals > maxContractDecimals {
+		return fmt.Errorf("contract decimals should be between 0 and %d", maxContractDecimals)
+	}
+
+	if _, err := ScaleAmount(msg.Amount, msg.ContractDecimals); err != nil {
+		return err
 	}
 
 	if msg.ExpireTime <= 0 {
@@ -163,7 +166,7 @@ func (msg BindMsg) ValidateBasic() error {
 }
 
 func (msg BindMsg) GetSignBytes() []byte {
-	b, err := json.Marshal(msg) // XXX: ensure some canonical form
+	b, err := CanonicalSignBytes(msg)
 	if err != nil {
 		panic(err)
 	}
@@ -206,6 +209,14 @@ func (msg TransferOutMsg) ValidateBasic() error {
 		return fmt.Errorf("amount should be positive")
 	}
 
+	// The bound token's contract decimals aren't known at this layer, so
+	// validate against the worst case (maxContractDecimals) to catch an
+	// amount that could never be scaled to any valid contract before it
+	// reaches consensus.
+	if _, err := ScaleAmount(msg.Amount.Amount, maxContractDecimals); err != nil {
+		return err
+	}
+
 	if msg.ExpireTime <= 0 {
 		return fmt.Errorf("expire time should be larger than 0")
 	}
@@ -213,7 +224,7 @@ func (msg TransferOutMsg) ValidateBasic() error {
 	return nil
 }
 func (msg TransferOutMsg) GetSignBytes() []byte {
-	b, err := json.Marshal(msg) // XXX: ensure some canonical form
+	b, err := CanonicalSignBytes(msg)
 	if err != nil {
 		panic(err)
 	}